@@ -0,0 +1,172 @@
+package gosearch
+
+// Hashable lets a State be keyed in the visited maps that
+// SearchBidirectional needs to compare the forward and backward
+// frontiers. It is kept as a separate interface, rather than folded
+// into State, so that states which never run a bidirectional search
+// are not forced to implement it.
+type Hashable interface {
+	// StateKey returns a string that uniquely identifies the state
+	// for the purpose of equality checks, analogous to Equal but
+	// cheap enough to use as a map key.
+	StateKey() string
+}
+
+// ReversibleState extends State with the ability to generate
+// predecessors, which SearchBidirectional needs to grow a frontier
+// backwards from the goal. Implementations must ensure that
+// ApplyReverseAction is a true inverse of ApplyAction: applying a
+// reverse action produced for a given forward action must yield back
+// a state equal (by Equal) to the one the forward action started
+// from, or the plan stitched together by SearchBidirectional will not
+// be valid.
+type ReversibleState interface {
+	State
+	Hashable
+
+	// GetApplicableReverseActions returns the actions that could have
+	// led to this state, i.e. the inverses of GetApplicableActions as
+	// seen from a predecessor.
+	GetApplicableReverseActions() []Action
+
+	// ApplyReverseAction returns the predecessor state reached by
+	// undoing action.
+	ApplyReverseAction(action Action) State
+}
+
+// bidirectionalNode pairs a state reached while growing a frontier
+// with the single action that produced it, so that a stitched path
+// can be reconstructed by walking parent pointers.
+type bidirectionalNode struct {
+	state  ReversibleState
+	parent *bidirectionalNode
+	action Action
+}
+
+// SearchBidirectional implements a bidirectional breadth-first search
+// (https://en.wikipedia.org/wiki/Bidirectional_search): it grows a
+// frontier forward from initial and another backward from goal one
+// level at a time, and stops as soon as the two frontiers meet,
+// visiting on the order of 2*b^(d/2) nodes instead of the b^d a
+// single-direction BFS would need. Both initial and goal must
+// implement ReversibleState so that the backward frontier can
+// generate predecessors via ApplyReverseAction. The returned
+// Statistics report NodesExplored as the combined total, plus the
+// per-side breakdown in ForwardNodesExplored/BackwardNodesExplored so
+// the asymmetry the algorithm exploits is visible to callers.
+func SearchBidirectional(initial, goal ReversibleState) ([]Action, Statistics) {
+
+	stats := Statistics{}
+
+	forwardVisited := map[string]*bidirectionalNode{initial.StateKey(): {state: initial}}
+	backwardVisited := map[string]*bidirectionalNode{goal.StateKey(): {state: goal}}
+
+	forwardFrontier := []*bidirectionalNode{forwardVisited[initial.StateKey()]}
+	backwardFrontier := []*bidirectionalNode{backwardVisited[goal.StateKey()]}
+
+	if initial.StateKey() == goal.StateKey() {
+		return []Action{}, stats
+	}
+
+	for len(forwardFrontier) > 0 && len(backwardFrontier) > 0 {
+
+		if meeting := expandFrontier(&forwardFrontier, forwardVisited, backwardVisited, &stats.ForwardNodesExplored, &stats, false); meeting != nil {
+			stats.NodesExplored = stats.ForwardNodesExplored + stats.BackwardNodesExplored
+			return stitchBidirectionalPath(meeting), stats
+		}
+
+		if meeting := expandFrontier(&backwardFrontier, backwardVisited, forwardVisited, &stats.BackwardNodesExplored, &stats, true); meeting != nil {
+			stats.NodesExplored = stats.ForwardNodesExplored + stats.BackwardNodesExplored
+			return stitchBidirectionalPath(meeting), stats
+		}
+	}
+
+	stats.NodesExplored = stats.ForwardNodesExplored + stats.BackwardNodesExplored
+	return []Action{}, stats // no solution
+}
+
+// meetingNode pairs the node found while expanding one side with the
+// matching node already present on the other side's visited map.
+type meetingNode struct {
+	own      *bidirectionalNode
+	opposite *bidirectionalNode
+	reverse  bool
+}
+
+// expandFrontier pops every node currently in frontier (one BFS
+// level), expands it using GetApplicableActions or
+// GetApplicableReverseActions depending on reverse, and records newly
+// seen states in visited. sideExplored accumulates this side's own
+// node count, while stats.MaxDepth and stats.NodesDuplicated stay
+// combined across both sides. It returns non-nil as soon as a newly
+// generated state is already present in opposite, meaning the two
+// frontiers have met.
+func expandFrontier(frontier *[]*bidirectionalNode, visited, opposite map[string]*bidirectionalNode, sideExplored *int, stats *Statistics, reverse bool) *meetingNode {
+
+	level := *frontier
+	*frontier = nil
+
+	for _, node := range level {
+		*sideExplored++
+		stats.MaxDepth = max(stats.MaxDepth, node.state.GetStateLevel())
+
+		actions := node.state.GetApplicableActions()
+		if reverse {
+			actions = node.state.GetApplicableReverseActions()
+		}
+
+		for _, action := range actions {
+			var nextState State
+			if reverse {
+				nextState = node.state.ApplyReverseAction(action)
+			} else {
+				nextState = node.state.ApplyAction(action)
+			}
+			next, ok := nextState.(ReversibleState)
+			if !ok {
+				continue
+			}
+
+			key := next.StateKey()
+			if _, seen := visited[key]; seen {
+				stats.NodesDuplicated++
+				continue
+			}
+
+			child := &bidirectionalNode{state: next, parent: node, action: action}
+			visited[key] = child
+			*frontier = append(*frontier, child)
+
+			if other, met := opposite[key]; met {
+				return &meetingNode{own: child, opposite: other, reverse: reverse}
+			}
+		}
+	}
+
+	return nil
+}
+
+// stitchBidirectionalPath reconstructs the full solution by walking
+// the meeting node's forward-side ancestry to build the prefix, then
+// walking its backward-side ancestry to build the suffix. Each
+// backward-side action is the forward action that leads from that
+// node to its parent, so it is appended unchanged.
+func stitchBidirectionalPath(meeting *meetingNode) []Action {
+
+	forwardNode, backwardNode := meeting.own, meeting.opposite
+	if meeting.reverse {
+		forwardNode, backwardNode = meeting.opposite, meeting.own
+	}
+
+	var prefix []Action
+	for n := forwardNode; n != nil && n.parent != nil; n = n.parent {
+		prefix = append([]Action{n.action}, prefix...)
+	}
+
+	var suffix []Action
+	for n := backwardNode; n != nil && n.parent != nil; n = n.parent {
+		suffix = append(suffix, n.action)
+	}
+
+	return append(prefix, suffix...)
+}
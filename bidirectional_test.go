@@ -0,0 +1,116 @@
+package gosearch
+
+import (
+	"strconv"
+	"testing"
+)
+
+// pathAction steps along a bounded line graph by delta (+1 or -1).
+type pathAction struct {
+	delta int
+}
+
+func (a pathAction) Cost() float64 { return 1 }
+
+// pathState is a minimal ReversibleState on a line graph
+// 0 <-> 1 <-> ... <-> max, used to exercise SearchBidirectional's
+// frontier stitching.
+type pathState struct {
+	position int
+	max      int
+	level    int
+	path     []Action
+}
+
+func (s pathState) ApplyAction(action Action) State {
+	a := action.(pathAction)
+	return pathState{
+		position: s.position + a.delta,
+		max:      s.max,
+		level:    s.level + 1,
+		path:     append(append([]Action{}, s.path...), a),
+	}
+}
+
+func (s pathState) GetPartialSolution() []Action { return s.path }
+func (s pathState) GetSolutionCost() float64     { return float64(len(s.path)) }
+
+func (s pathState) GetApplicableActions() []Action {
+	var actions []Action
+	for _, delta := range []int{1, -1} {
+		if next := s.position + delta; next >= 0 && next <= s.max {
+			actions = append(actions, pathAction{delta: delta})
+		}
+	}
+	return actions
+}
+
+func (s pathState) IsSolution() bool { return false }
+
+func (s pathState) Equal(other State) bool {
+	o, ok := other.(pathState)
+	return ok && o.position == s.position
+}
+
+func (s pathState) GetStateLevel() int { return s.level }
+func (s pathState) String() string     { return strconv.Itoa(s.position) }
+func (s pathState) StateKey() string   { return strconv.Itoa(s.position) }
+
+// GetApplicableReverseActions mirrors GetApplicableActions: the graph
+// is undirected, so the same delta set is valid as long as the
+// resulting predecessor stays on the line.
+func (s pathState) GetApplicableReverseActions() []Action {
+	var actions []Action
+	for _, delta := range []int{1, -1} {
+		if predecessor := s.position - delta; predecessor >= 0 && predecessor <= s.max {
+			actions = append(actions, pathAction{delta: delta})
+		}
+	}
+	return actions
+}
+
+// ApplyReverseAction is the true inverse of ApplyAction: applying the
+// same action forward to the returned predecessor reproduces s.
+func (s pathState) ApplyReverseAction(action Action) State {
+	a := action.(pathAction)
+	return pathState{
+		position: s.position - a.delta,
+		max:      s.max,
+		level:    s.level + 1,
+		path:     append(append([]Action{}, s.path...), a),
+	}
+}
+
+func TestSearchBidirectionalFindsShortestPath(t *testing.T) {
+	initial := pathState{position: 0, max: 10}
+	goal := pathState{position: 5, max: 10}
+
+	actions, stats := SearchBidirectional(initial, goal)
+
+	if len(actions) != 5 {
+		t.Fatalf("expected the shortest path (5 steps) between 0 and 5, got %d actions (%v)", len(actions), actions)
+	}
+
+	position := initial.position
+	for _, a := range actions {
+		position += a.(pathAction).delta
+	}
+	if position != goal.position {
+		t.Fatalf("stitched path does not lead to the goal: ended at %d, want %d", position, goal.position)
+	}
+
+	if stats.ForwardNodesExplored == 0 || stats.BackwardNodesExplored == 0 {
+		t.Fatalf("expected both sides to explore at least one node, got forward=%d backward=%d", stats.ForwardNodesExplored, stats.BackwardNodesExplored)
+	}
+	if stats.NodesExplored != stats.ForwardNodesExplored+stats.BackwardNodesExplored {
+		t.Fatalf("NodesExplored (%d) should equal the sum of both sides (%d)", stats.NodesExplored, stats.ForwardNodesExplored+stats.BackwardNodesExplored)
+	}
+}
+
+func TestSearchBidirectionalSameStartAndGoal(t *testing.T) {
+	s := pathState{position: 3, max: 10}
+	actions, _ := SearchBidirectional(s, s)
+	if len(actions) != 0 {
+		t.Fatalf("expected an empty plan when initial equals goal, got %v", actions)
+	}
+}
@@ -0,0 +1,43 @@
+package gosearch
+
+// SearchAstarWith runs A* exactly like SearchAstar but lets the
+// heuristic and the action cost be supplied independently of the
+// state's own types, so the same State can be searched under several
+// heuristics (e.g. Manhattan distance vs. linear conflict on a
+// 15-puzzle) without those heuristics living on the state itself. If
+// h is nil, the search falls back to the state's Heuristic
+// implementation and panics if it has none, since A* cannot order its
+// frontier without a heuristic of some kind. If c is nil, it falls
+// back to Action.Cost().
+func SearchAstarWith(initial State, h HeuristicFunc, c CostFunc) ([]Action, Statistics) {
+
+	if h == nil {
+		if _, ok := initial.(Heuristic); !ok {
+			panic("gosearch: SearchAstarWith requires a heuristic, either via h or the Heuristic interface")
+		}
+	}
+
+	solver := NewSearchSolver(initial)
+	solver.Strategy = StrategyAstar
+	solver.Heuristic = h
+	solver.Cost = c
+	return solver.Run()
+}
+
+// SearchGreedyBestFirstWith orders the frontier by the heuristic alone
+// (ignoring the accumulated cost g), which can find a solution faster
+// than A* at the cost of optimality. As with SearchAstarWith, h falls
+// back to the Heuristic interface when nil.
+func SearchGreedyBestFirstWith(initial State, h HeuristicFunc) ([]Action, Statistics) {
+
+	if h == nil {
+		if _, ok := initial.(Heuristic); !ok {
+			panic("gosearch: SearchGreedyBestFirstWith requires a heuristic, either via h or the Heuristic interface")
+		}
+	}
+
+	solver := NewSearchSolver(initial)
+	solver.Strategy = StrategyGreedyBestFirst
+	solver.Heuristic = h
+	return solver.Run()
+}
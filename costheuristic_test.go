@@ -0,0 +1,132 @@
+package gosearch
+
+import "testing"
+
+// diamondAction identifies one of the three moves in diamondState's
+// two-route diamond graph: start -[viaB]-> B -[toD]-> D and
+// start -[viaC]-> C -[toD]-> D.
+type diamondAction int
+
+const (
+	viaB diamondAction = iota
+	viaC
+	toD
+)
+
+func (a diamondAction) Cost() float64 { return 1 }
+
+// diamondState is a minimal four-node graph (start, B, C, D) with two
+// equal-length routes from start to D, used to show that a custom
+// CostFunc - not just the action's own Cost() - decides which route
+// SearchAstarWith actually picks. It deliberately does not implement
+// Heuristic, so it also doubles as the fixture for the no-heuristic
+// panic path.
+type diamondState struct {
+	name string
+	path []Action
+}
+
+func (s diamondState) ApplyAction(action Action) State {
+	next := diamondState{path: append(append([]Action{}, s.path...), action)}
+	switch action.(diamondAction) {
+	case viaB:
+		next.name = "B"
+	case viaC:
+		next.name = "C"
+	case toD:
+		next.name = "D"
+	}
+	return next
+}
+
+func (s diamondState) GetPartialSolution() []Action { return s.path }
+func (s diamondState) GetSolutionCost() float64     { return float64(len(s.path)) }
+
+func (s diamondState) GetApplicableActions() []Action {
+	switch s.name {
+	case "start", "":
+		return []Action{viaB, viaC}
+	case "B", "C":
+		return []Action{toD}
+	default:
+		return nil
+	}
+}
+
+func (s diamondState) IsSolution() bool { return s.name == "D" }
+
+func (s diamondState) Equal(other State) bool {
+	o, ok := other.(diamondState)
+	return ok && o.name == s.name
+}
+
+func (s diamondState) GetStateLevel() int { return len(s.path) }
+func (s diamondState) String() string     { return s.name }
+
+// zeroHeuristic turns SearchAstarWith into a plain uniform-cost search
+// so the route it picks is driven entirely by CostFunc.
+func zeroHeuristic(State) float64 { return 0 }
+
+func TestSearchAstarWithCustomCostChangesChosenRoute(t *testing.T) {
+	cheapViaC := func(from State, a Action, to State) float64 {
+		if a.(diamondAction) == viaB {
+			return 10
+		}
+		return 1
+	}
+
+	cheapest, _ := SearchAstarWith(diamondState{name: "start"}, zeroHeuristic, nil)
+	if len(cheapest) == 0 || cheapest[0] != viaB {
+		t.Fatalf("expected the default uniform cost to take the B route first, got %v", cheapest)
+	}
+
+	rerouted, _ := SearchAstarWith(diamondState{name: "start"}, zeroHeuristic, cheapViaC)
+	if len(rerouted) == 0 || rerouted[0] != viaC {
+		t.Fatalf("expected the custom CostFunc to make the C route cheaper and get picked first, got %v", rerouted)
+	}
+}
+
+func TestSearchAstarWithFallsBackToStateHeuristic(t *testing.T) {
+	actions, stats := SearchAstarWith(lineState{position: 0, target: 7}, nil, nil)
+	if len(actions) != 4 {
+		t.Fatalf("expected lineState's own Heuristic to drive an optimal 4-action solution, got %d", len(actions))
+	}
+	if stats.Solutions != 1 {
+		t.Fatalf("expected exactly one solution counted, got %d", stats.Solutions)
+	}
+}
+
+func TestSearchAstarWithPanicsWithoutHeuristic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected a panic when neither h nor the Heuristic interface is available")
+		}
+	}()
+	SearchAstarWith(diamondState{name: "start"}, nil, nil)
+}
+
+func TestSearchGreedyBestFirstWithPanicsWithoutHeuristic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected a panic when neither h nor the Heuristic interface is available")
+		}
+	}()
+	SearchGreedyBestFirstWith(diamondState{name: "start"}, nil)
+}
+
+func TestSearchGreedyBestFirstWithCustomHeuristic(t *testing.T) {
+	reachD := func(s State) float64 {
+		if s.(diamondState).name == "D" {
+			return 0
+		}
+		return 1
+	}
+
+	actions, stats := SearchGreedyBestFirstWith(diamondState{name: "start"}, reachD)
+	if len(actions) != 2 {
+		t.Fatalf("expected the 2-action route to D, got %v", actions)
+	}
+	if stats.Solutions != 1 {
+		t.Fatalf("expected exactly one solution counted, got %d", stats.Solutions)
+	}
+}
@@ -0,0 +1,91 @@
+package gosearch
+
+import "math"
+
+// SearchIDAstar implements the Iterative Deepening A* algorithm
+// (https://en.wikipedia.org/wiki/Iterative_deepening_A*) to search a
+// solution state for a problem. Like SearchAstar, the State must also
+// implement the Heuristic interface, and the heuristic must be
+// admissible (never overestimate the true remaining cost) for the
+// returned solution to be optimal. Unlike SearchAstar, IDA* keeps the
+// memory profile of a depth-first search by repeating a bounded DFS
+// instead of keeping every generated node on an open list, at the
+// cost of revisiting nodes across iterations.
+// The initial state of the problem must be provided and as result the
+// algorithm returns the list of solution action (if the problem as
+// solution) and a basic statistics about the nodes explored,
+// duplicate nodes and the maximum depth explored.
+func SearchIDAstar(initial State) ([]Action, Statistics) {
+
+	h, ok := initial.(Heuristic)
+	if !ok {
+		return []Action{}, Statistics{}
+	}
+
+	bound := h.Heuristic()
+	stats := Statistics{}
+
+	for {
+		solution, next, iteration := idaDepthFirst(initial, bound)
+		stats.NodesExplored += iteration.NodesExplored
+		stats.NodesDuplicated += iteration.NodesDuplicated
+		stats.MaxDepth = max(stats.MaxDepth, iteration.MaxDepth)
+
+		if solution != nil {
+			stats.Solutions++
+			return solution, stats
+		}
+
+		if math.IsInf(next, 1) {
+			return []Action{}, stats // no solution
+		}
+
+		bound = next
+	}
+}
+
+// idaDepthFirst runs one bounded DFS iteration of IDA*, pruning any
+// node whose f = g + h exceeds bound. It returns the solution actions
+// when found (nil otherwise), the minimum f value seen among the
+// pruned nodes (+Inf if none were pruned, meaning the whole state
+// space was exhausted) and the Statistics for this iteration alone.
+func idaDepthFirst(initial State, bound float64) ([]Action, float64, Statistics) {
+
+	stats := Statistics{}
+	minExceeded := math.Inf(1)
+
+	var recurse func(state State) []Action
+	recurse = func(state State) []Action {
+		stats.NodesExplored++
+		stats.MaxDepth = max(stats.MaxDepth, state.GetStateLevel())
+
+		g := state.GetSolutionCost()
+		f := g
+		if h, ok := state.(Heuristic); ok {
+			f += h.Heuristic()
+		}
+
+		if f > bound {
+			if f < minExceeded {
+				minExceeded = f
+			}
+			return nil
+		}
+
+		if state.IsSolution() {
+			return state.GetPartialSolution()
+		}
+
+		for _, action := range state.GetApplicableActions() {
+			next := state.ApplyAction(action)
+			if solution := recurse(next); solution != nil {
+				return solution
+			}
+		}
+
+		return nil
+	}
+
+	solution := recurse(initial)
+	return solution, minExceeded, stats
+}
@@ -0,0 +1,94 @@
+package gosearch
+
+import (
+	"strconv"
+	"testing"
+)
+
+// lineAction moves a lineState forward by step positions at a fixed cost.
+type lineAction struct {
+	step int
+	cost float64
+}
+
+func (a lineAction) Cost() float64 { return a.cost }
+
+// lineState is a minimal State/Heuristic implementation used to
+// exercise the single-agent searches against a simple 1D line graph:
+// 0 -> 1 -> 2 -> ... -> target, reachable via steps of 1 or 2.
+type lineState struct {
+	position int
+	target   int
+	path     []Action
+	cost     float64
+}
+
+func (s lineState) ApplyAction(action Action) State {
+	a := action.(lineAction)
+	return lineState{
+		position: s.position + a.step,
+		target:   s.target,
+		path:     append(append([]Action{}, s.path...), a),
+		cost:     s.cost + a.cost,
+	}
+}
+
+func (s lineState) GetPartialSolution() []Action { return s.path }
+func (s lineState) GetSolutionCost() float64     { return s.cost }
+
+func (s lineState) GetApplicableActions() []Action {
+	if s.position >= s.target {
+		return nil
+	}
+	return []Action{lineAction{step: 1, cost: 1}, lineAction{step: 2, cost: 1}}
+}
+
+func (s lineState) IsSolution() bool { return s.position == s.target }
+
+func (s lineState) Equal(other State) bool {
+	o, ok := other.(lineState)
+	return ok && o.position == s.position
+}
+
+func (s lineState) GetStateLevel() int { return len(s.path) }
+
+func (s lineState) String() string { return strconv.Itoa(s.position) }
+
+// Heuristic is admissible: the true remaining cost is at least
+// ceil(remaining/2) actions, and remaining/2 never overestimates that.
+func (s lineState) Heuristic() float64 {
+	remaining := s.target - s.position
+	if remaining < 0 {
+		return 0
+	}
+	return float64(remaining) / 2
+}
+
+func TestSearchIDAstarFindsOptimalPath(t *testing.T) {
+	actions, stats := SearchIDAstar(lineState{position: 0, target: 7})
+
+	if len(actions) != 4 { // steps of up to 2 cover 7 in 4 moves, e.g. 2+2+2+1
+		t.Fatalf("expected a 4-action solution, got %d actions (%v)", len(actions), actions)
+	}
+
+	var reached int
+	for _, a := range actions {
+		reached += a.(lineAction).step
+	}
+	if reached != 7 {
+		t.Fatalf("solution does not reach the target: moved %d, want 7", reached)
+	}
+	if stats.NodesExplored == 0 {
+		t.Fatalf("expected at least one node explored")
+	}
+	if stats.Solutions != 1 {
+		t.Fatalf("expected exactly one solution to be counted, got %d", stats.Solutions)
+	}
+}
+
+func TestSearchIDAstarNoSolution(t *testing.T) {
+	actions, _ := SearchIDAstar(lineState{position: 0, target: -1})
+	if len(actions) != 0 {
+		t.Fatalf("expected no solution for an unreachable target, got %v", actions)
+	}
+}
@@ -0,0 +1,192 @@
+package gosearch
+
+import (
+	"math"
+	"time"
+)
+
+// AdversarialState is a state in a two-player zero-sum game, as
+// opposed to the single-agent planning problems modeled by State.
+// Evaluate must return the static evaluation of the state from the
+// perspective of the side to move, i.e. positive values favour
+// SideToMove and negative values favour the opponent.
+type AdversarialState interface {
+	// ApplyAction returns the state reached after the side to move
+	// plays action.
+	ApplyAction(action Action) AdversarialState
+
+	// GetApplicableActions returns the actions available to the side to move.
+	GetApplicableActions() []Action
+
+	// IsTerminal reports whether the game is over (win, loss or draw).
+	IsTerminal() bool
+
+	// Evaluate returns a static evaluation of the state from the
+	// perspective of the side to move. It is only consulted at the
+	// search horizon (depth 0) or at a terminal node.
+	Evaluate() float64
+
+	// SideToMove identifies whose turn it is to act, e.g. +1/-1 or 0/1.
+	SideToMove() int
+}
+
+// ZobristState is an AdversarialState that can report a Zobrist hash
+// (https://en.wikipedia.org/wiki/Zobrist_hashing) of itself, letting
+// SearchNegamaxID reuse values computed for a state across iterative
+// deepening iterations via a transposition table.
+type ZobristState interface {
+	AdversarialState
+	ZobristKey() uint64
+}
+
+// SearchNegamax runs the negamax algorithm
+// (https://en.wikipedia.org/wiki/Negamax) with alpha-beta pruning
+// (https://en.wikipedia.org/wiki/Alpha%E2%80%93beta_pruning) to
+// `depth` plies, implementing the recurrence
+//
+//	negamax(s, d, a, b) = max over actions x of -negamax(apply(s,x), d-1, -b, -a)
+//
+// returning s.Evaluate() once d reaches 0 or s is terminal. It
+// returns the best action found (nil if s has none, e.g. it is
+// already terminal), that action's negamax value from s's side to
+// move's perspective, and search Statistics (NodesExplored and
+// AlphaBetaCutoffs).
+func SearchNegamax(s AdversarialState, depth int) (Action, float64, Statistics) {
+	stats := Statistics{}
+	action, value := negamax(s, depth, math.Inf(-1), math.Inf(1), &stats, nil)
+	return action, value, stats
+}
+
+// ttBound records whether a negamaxEntry's value is the exact negamax
+// value for the state, or only a bound on it produced by an
+// alpha-beta cutoff.
+type ttBound int
+
+const (
+	// ttExact means entry.value is the true negamax value.
+	ttExact ttBound = iota
+	// ttLowerBound means the true value is at least entry.value (the
+	// search failed high: a cutoff happened before every action was tried).
+	ttLowerBound
+	// ttUpperBound means the true value is at most entry.value (the
+	// search failed low: no action improved alpha).
+	ttUpperBound
+)
+
+// negamaxEntry is one transposition table record: the best action and
+// value found for a state the last time it was searched to depth,
+// together with the bound type of value.
+type negamaxEntry struct {
+	action Action
+	value  float64
+	depth  int
+	bound  ttBound
+}
+
+// negamax implements the search behind SearchNegamax; table, when
+// non-nil, is consulted and updated as a transposition table keyed by
+// ZobristKey so that SearchNegamaxID can reuse values across
+// iterations. Table entries store whether their value is exact or
+// only a bound, and a stored entry is only used to narrow the
+// alpha-beta window (or short-circuit outright) when its bound type
+// is compatible with the caller's window, as is standard for an
+// alpha-beta search backed by a transposition table.
+func negamax(s AdversarialState, depth int, alpha, beta float64, stats *Statistics, table map[uint64]negamaxEntry) (Action, float64) {
+
+	stats.NodesExplored++
+	alphaOrig := alpha
+
+	var key uint64
+	var hasKey bool
+	if zs, ok := s.(ZobristState); ok && table != nil {
+		key, hasKey = zs.ZobristKey(), true
+		if entry, found := table[key]; found && entry.depth >= depth {
+			switch entry.bound {
+			case ttExact:
+				return entry.action, entry.value
+			case ttLowerBound:
+				if entry.value > alpha {
+					alpha = entry.value
+				}
+			case ttUpperBound:
+				if entry.value < beta {
+					beta = entry.value
+				}
+			}
+			if alpha >= beta {
+				return entry.action, entry.value
+			}
+		}
+	}
+
+	if depth == 0 || s.IsTerminal() {
+		return nil, s.Evaluate()
+	}
+
+	actions := s.GetApplicableActions()
+	if len(actions) == 0 {
+		return nil, s.Evaluate()
+	}
+
+	var bestAction Action
+	bestValue := math.Inf(-1)
+
+	for _, action := range actions {
+		_, value := negamax(s.ApplyAction(action), depth-1, -beta, -alpha, stats, table)
+		value = -value
+
+		if value > bestValue {
+			bestValue = value
+			bestAction = action
+		}
+		if bestValue > alpha {
+			alpha = bestValue
+		}
+		if alpha >= beta {
+			stats.AlphaBetaCutoffs++
+			break
+		}
+	}
+
+	if hasKey {
+		bound := ttExact
+		switch {
+		case bestValue <= alphaOrig:
+			bound = ttUpperBound
+		case bestValue >= beta:
+			bound = ttLowerBound
+		}
+		table[key] = negamaxEntry{action: bestAction, value: bestValue, depth: depth, bound: bound}
+	}
+
+	return bestAction, bestValue
+}
+
+// SearchNegamaxID runs SearchNegamax at increasing depths, from 1 up
+// to maxDepth, stopping early once budget has elapsed. Deeper
+// iterations reuse values computed for states seen at an equal or
+// greater depth in earlier iterations via a transposition table keyed
+// by ZobristKey, provided s implements ZobristState; otherwise it
+// behaves like a plain loop over SearchNegamax. It returns the best
+// action and value found by the deepest completed iteration, together
+// with the aggregated Statistics across all iterations run.
+func SearchNegamaxID(s AdversarialState, maxDepth int, budget time.Duration) (Action, float64, Statistics) {
+
+	deadline := time.Now().Add(budget)
+	table := map[uint64]negamaxEntry{}
+	stats := Statistics{}
+
+	var bestAction Action
+	var bestValue float64
+
+	for depth := 1; depth <= maxDepth; depth++ {
+		if budget > 0 && time.Now().After(deadline) {
+			break
+		}
+
+		action, value := negamax(s, depth, math.Inf(-1), math.Inf(1), &stats, table)
+		bestAction, bestValue = action, value
+	}
+
+	return bestAction, bestValue, stats
+}
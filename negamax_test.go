@@ -0,0 +1,192 @@
+package gosearch
+
+import (
+	"testing"
+	"time"
+)
+
+// nimAction takes stones from a nimState's pile.
+type nimAction struct {
+	take int
+}
+
+func (a nimAction) Cost() float64 { return 1 }
+
+// nimState is a misère-free Nim: players alternately take 1 or 2
+// stones from a single pile, and whoever takes the last stone wins.
+// Optimal play is well known: a position is winning for the side to
+// move iff stones%3 != 0, by moving to leave a multiple of 3.
+type nimState struct {
+	stones int
+	side   int
+}
+
+func (s nimState) ApplyAction(action Action) AdversarialState {
+	a := action.(nimAction)
+	return nimState{stones: s.stones - a.take, side: -s.side}
+}
+
+func (s nimState) GetApplicableActions() []Action {
+	var actions []Action
+	for _, take := range []int{1, 2} {
+		if take <= s.stones {
+			actions = append(actions, nimAction{take: take})
+		}
+	}
+	return actions
+}
+
+func (s nimState) IsTerminal() bool { return s.stones == 0 }
+
+// Evaluate is only consulted at a terminal node here: no stones left
+// means the side to move has nothing to take, so the player who just
+// moved took the last stone and won - a loss from the mover's side.
+func (s nimState) Evaluate() float64 {
+	if s.stones == 0 {
+		return -1
+	}
+	return 0
+}
+
+func (s nimState) SideToMove() int { return s.side }
+
+// ZobristKey hashes the only two fields that determine a nimState: the
+// pile size and the side to move. It lets SearchNegamaxID exercise its
+// transposition table against a real game instead of only a synthetic
+// fixture.
+func (s nimState) ZobristKey() uint64 {
+	side := uint64(0)
+	if s.side > 0 {
+		side = 1
+	}
+	return uint64(s.stones)<<1 | side
+}
+
+func TestSearchNegamaxFindsWinningMove(t *testing.T) {
+	action, value, stats := SearchNegamax(nimState{stones: 4, side: 1}, 10)
+
+	if action.(nimAction).take != 1 {
+		t.Fatalf("expected the winning move to take 1 stone (leaving a multiple of 3), got take %d", action.(nimAction).take)
+	}
+	if value <= 0 {
+		t.Fatalf("expected a winning (positive) evaluation, got %v", value)
+	}
+	if stats.NodesExplored == 0 {
+		t.Fatalf("expected at least one node explored")
+	}
+}
+
+func TestSearchNegamaxFindsLosingPosition(t *testing.T) {
+	_, value, _ := SearchNegamax(nimState{stones: 3, side: 1}, 10)
+	if value >= 0 {
+		t.Fatalf("stones%%3==0 should be a loss for the side to move, got evaluation %v", value)
+	}
+}
+
+// TestSearchNegamaxIDAgreesWithSearchNegamax guards against the
+// transposition table returning a stale bound instead of the exact
+// value: both searches must agree on the best move and its value. Now
+// that nimState implements ZobristKey, SearchNegamaxID's later,
+// deeper iterations genuinely probe the table entries left behind by
+// earlier, shallower ones instead of skipping the table path entirely.
+func TestSearchNegamaxIDAgreesWithSearchNegamax(t *testing.T) {
+	s := nimState{stones: 4, side: 1}
+
+	plainAction, plainValue, _ := SearchNegamax(s, 10)
+	idAction, idValue, stats := SearchNegamaxID(s, 10, time.Second)
+
+	if idAction.(nimAction).take != plainAction.(nimAction).take {
+		t.Fatalf("SearchNegamaxID chose take %d, SearchNegamax chose take %d", idAction.(nimAction).take, plainAction.(nimAction).take)
+	}
+	if idValue != plainValue {
+		t.Fatalf("SearchNegamaxID evaluated %v, SearchNegamax evaluated %v", idValue, plainValue)
+	}
+	if stats.NodesExplored == 0 {
+		t.Fatalf("expected at least one node explored")
+	}
+}
+
+// probeAction is the sole action reported by probeState; it only
+// needs to exist so a negamaxEntry can carry a non-nil action.
+type probeAction struct{}
+
+func (probeAction) Cost() float64 { return 1 }
+
+// probeState is a trivial, already-terminal ZobristState used to
+// probe negamax's transposition-table handling (lines 99-120 of
+// negamax.go) directly, independent of any real game tree: its fixed
+// key and Evaluate let a test plant a negamaxEntry by hand and check
+// exactly how negamax reacts to it.
+type probeState struct{}
+
+func (probeState) ApplyAction(Action) AdversarialState { return probeState{} }
+func (probeState) GetApplicableActions() []Action      { return []Action{probeAction{}} }
+func (probeState) IsTerminal() bool                    { return true }
+func (probeState) Evaluate() float64                   { return 5 }
+func (probeState) SideToMove() int                     { return 1 }
+func (probeState) ZobristKey() uint64                  { return 42 }
+
+// TestNegamaxTranspositionTableHonoursBoundType plants table entries
+// by hand and calls negamax directly, confirming it never treats a
+// lower/upper bound entry as if it were exact: a bound may only narrow
+// alpha/beta (falling through to the real Evaluate when that doesn't
+// produce a cutoff) or short-circuit when it does, but it must never
+// substitute its own value for the true one the way an ttExact entry does.
+func TestNegamaxTranspositionTableHonoursBoundType(t *testing.T) {
+	s := probeState{}
+
+	t.Run("exact entry short-circuits regardless of Evaluate", func(t *testing.T) {
+		table := map[uint64]negamaxEntry{
+			s.ZobristKey(): {action: probeAction{}, value: 7, depth: 2, bound: ttExact},
+		}
+		stats := &Statistics{}
+		_, value := negamax(s, 2, 0, 10, stats, table)
+		if value != 7 {
+			t.Fatalf("expected the exact entry's value 7 to be returned as-is, got %v", value)
+		}
+	})
+
+	t.Run("lower bound narrows alpha but still falls through to Evaluate", func(t *testing.T) {
+		table := map[uint64]negamaxEntry{
+			s.ZobristKey(): {action: probeAction{}, value: 3, depth: 2, bound: ttLowerBound},
+		}
+		stats := &Statistics{}
+		_, value := negamax(s, 2, 0, 10, stats, table)
+		if value != s.Evaluate() {
+			t.Fatalf("expected the lower bound to only narrow the window and fall through to Evaluate() (%v), got %v", s.Evaluate(), value)
+		}
+	})
+
+	t.Run("upper bound narrows beta but still falls through to Evaluate", func(t *testing.T) {
+		table := map[uint64]negamaxEntry{
+			s.ZobristKey(): {action: probeAction{}, value: 2, depth: 2, bound: ttUpperBound},
+		}
+		stats := &Statistics{}
+		_, value := negamax(s, 2, 0, 10, stats, table)
+		if value != s.Evaluate() {
+			t.Fatalf("expected the upper bound to only narrow the window and fall through to Evaluate() (%v), got %v", s.Evaluate(), value)
+		}
+	})
+
+	t.Run("lower bound that closes the window short-circuits on its own value", func(t *testing.T) {
+		table := map[uint64]negamaxEntry{
+			s.ZobristKey(): {action: probeAction{}, value: 8, depth: 2, bound: ttLowerBound},
+		}
+		stats := &Statistics{}
+		_, value := negamax(s, 2, 0, 5, stats, table)
+		if value != 8 {
+			t.Fatalf("expected the cutoff to return the lower bound's own value 8 (not Evaluate()'s %v), got %v", s.Evaluate(), value)
+		}
+	})
+
+	t.Run("a shallower entry is ignored in favour of a fresh search", func(t *testing.T) {
+		table := map[uint64]negamaxEntry{
+			s.ZobristKey(): {action: probeAction{}, value: 99, depth: 1, bound: ttExact},
+		}
+		stats := &Statistics{}
+		_, value := negamax(s, 2, 0, 10, stats, table)
+		if value != s.Evaluate() {
+			t.Fatalf("expected an entry shallower than the requested depth to be ignored, got %v want %v", value, s.Evaluate())
+		}
+	})
+}
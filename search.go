@@ -59,6 +59,18 @@ type Statistics struct {
 	NodesDuplicated int
 	MaxDepth        int
 	Solutions       int
+
+	// AlphaBetaCutoffs counts the alpha-beta cutoffs triggered while
+	// searching. Only populated by the adversarial search functions
+	// (SearchNegamax, SearchNegamaxID); zero everywhere else.
+	AlphaBetaCutoffs int
+
+	// ForwardNodesExplored and BackwardNodesExplored break
+	// NodesExplored down by side. Only populated by SearchBidirectional,
+	// where the asymmetry between the two is the point of the
+	// algorithm; zero everywhere else.
+	ForwardNodesExplored  int
+	BackwardNodesExplored int
 }
 
 // Basic string default representation for the Statistics
@@ -68,12 +80,17 @@ func (stats Statistics) String() string {
 		"NodesExplored: " + strconv.Itoa(stats.NodesExplored) + ", " +
 		"NodesDuplicated: " + strconv.Itoa(stats.NodesDuplicated) + ", " +
 		"MaxDepth: " + strconv.Itoa(stats.MaxDepth) + ", " +
-		"Solutions: " + strconv.Itoa(stats.Solutions) +
+		"Solutions: " + strconv.Itoa(stats.Solutions) + ", " +
+		"AlphaBetaCutoffs: " + strconv.Itoa(stats.AlphaBetaCutoffs) +
 		"]"
 
 }
 
 // Search mechanism
+//
+// Each function below is a thin, pre-configured wrapper around a
+// SearchSolver; build one directly when MaxDepth, MaxNodes, Weight,
+// Dedup or custom cost/heuristic functions are needed.
 
 // SearchBreadthFirst is a basic search without domain information
 // BreadthFirst search algorithm
@@ -85,7 +102,9 @@ func (stats Statistics) String() string {
 // maximum depth explored.
 func SearchBreadthFirst(initialState State) ([]Action, Statistics) {
 
-	return findFirstSolution(initialState, new(queue))
+	solver := NewSearchSolver(initialState)
+	solver.Strategy = StrategyBreadthFirst
+	return solver.Run()
 }
 
 // SearchDepthFirst is a basic search without domain information Depth
@@ -97,7 +116,9 @@ func SearchBreadthFirst(initialState State) ([]Action, Statistics) {
 // and the maximum depth explored.
 func SearchDepthFirst(initialState State) ([]Action, Statistics) {
 
-	return findFirstSolution(initialState, new(stack))
+	solver := NewSearchSolver(initialState)
+	solver.Strategy = StrategyDepthFirst
+	return solver.Run()
 }
 
 // SearchIterativeDepth is a basic search without domain information
@@ -111,28 +132,9 @@ func SearchDepthFirst(initialState State) ([]Action, Statistics) {
 // duplicate nodes and the maximum depth explored.
 func SearchIterativeDepth(initial State) ([]Action, Statistics) {
 
-	// linear incremental
-	var solution []Action = []Action{}
-	var maxDepth int
-	stats := Statistics{NodesExplored: 0, NodesDuplicated: 0, MaxDepth: 0, Solutions: 0}
-	var statistics Statistics
-	depth := 1
-
-	for len(solution) == 0 {
-		solution, maxDepth, statistics =
-			findFirstSolutionAux(initial, new(stack), depth)
-		// aggregate stats
-		stats.NodesExplored += statistics.NodesExplored
-		stats.NodesDuplicated += statistics.NodesDuplicated
-		stats.MaxDepth = max(stats.MaxDepth, maxDepth)
-		stats.Solutions += statistics.Solutions
-		if depth > maxDepth {
-			return []Action{}, stats // no solution
-		}
-		depth++
-	}
-
-	return solution, stats
+	solver := NewSearchSolver(initial)
+	solver.Strategy = StrategyIterativeDepth
+	return solver.Run()
 }
 
 // SearchAstar implement an Astar algorithm
@@ -145,5 +147,7 @@ func SearchIterativeDepth(initial State) ([]Action, Statistics) {
 // duplicate nodes and the maximum depth explored.
 func SearchAstar(initialState State) ([]Action, Statistics) {
 
-	return findFirstSolutionAstar(initialState, new(floatPriorityList))
+	solver := NewSearchSolver(initialState)
+	solver.Strategy = StrategyAstar
+	return solver.Run()
 }
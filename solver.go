@@ -0,0 +1,340 @@
+package gosearch
+
+import "math"
+
+// SearchStrategy identifies which underlying search algorithm a
+// SearchSolver runs.
+type SearchStrategy int
+
+const (
+	// StrategyBreadthFirst explores nodes in FIFO order. See SearchBreadthFirst.
+	StrategyBreadthFirst SearchStrategy = iota
+	// StrategyDepthFirst explores nodes in LIFO order. See SearchDepthFirst.
+	StrategyDepthFirst
+	// StrategyIterativeDepth repeats a depth-bounded depth-first
+	// search with an increasing bound. See SearchIterativeDepth.
+	StrategyIterativeDepth
+	// StrategyAstar orders nodes by g+h and requires a heuristic. See SearchAstar.
+	StrategyAstar
+	// StrategyGreedyBestFirst orders nodes by h alone, ignoring the
+	// accumulated cost g.
+	StrategyGreedyBestFirst
+)
+
+// CostFunc overrides the cost of moving from state "from" to state
+// "to" via action a. A nil CostFunc makes a SearchSolver fall back to
+// a.Cost().
+type CostFunc func(from State, a Action, to State) float64
+
+// HeuristicFunc estimates the remaining distance from a state to a
+// solution. A nil HeuristicFunc makes a SearchSolver fall back to the
+// state's Heuristic implementation, if any.
+type HeuristicFunc func(s State) float64
+
+// SearchSolver configures and runs one of the search strategies in
+// this package against a single initial State. Build one with
+// NewSearchSolver, tweak the exported fields and call Run; the
+// zero-option case behaves exactly like the matching top-level
+// Search* function.
+type SearchSolver struct {
+	// Initial is the starting state for the search.
+	Initial State
+
+	// Strategy selects the search algorithm. Defaults to StrategyBreadthFirst.
+	Strategy SearchStrategy
+
+	// MaxDepth caps the depth explored. Zero means unbounded, except
+	// for StrategyIterativeDepth, where it is the final bound tried
+	// before giving up instead of growing forever.
+	MaxDepth int
+
+	// MaxNodes caps the number of nodes explored before the search
+	// gives up and reports no solution. Zero means unbounded.
+	MaxNodes int
+
+	// Weight multiplies the heuristic value used by StrategyAstar and
+	// StrategyGreedyBestFirst, turning on weighted A* for values > 1.
+	// Zero is treated as 1 (plain A*).
+	Weight float64
+
+	// Dedup toggles the duplicate-detection (memoization) table used
+	// to avoid re-expanding states already seen. Defaults to on; set
+	// to false to trade memory for the option of revisiting states.
+	Dedup bool
+
+	// Cost overrides Action.Cost() when set.
+	Cost CostFunc
+
+	// Heuristic overrides the state's Heuristic implementation when
+	// set. Required for StrategyAstar and StrategyGreedyBestFirst if
+	// Initial does not implement Heuristic.
+	Heuristic HeuristicFunc
+}
+
+// NewSearchSolver returns a SearchSolver for initial configured with
+// StrategyBreadthFirst and duplicate-detection enabled, mirroring the
+// defaults of the existing top-level Search* functions.
+func NewSearchSolver(initial State) *SearchSolver {
+	return &SearchSolver{Initial: initial, Strategy: StrategyBreadthFirst, Dedup: true}
+}
+
+// Run executes the configured strategy and returns the solution
+// actions (empty if none was found within MaxDepth/MaxNodes) together
+// with the search Statistics. Every strategy is driven by one of the
+// self-contained loops below (runUninformed, runIterativeDeepening,
+// runBestFirst), so a SearchSolver never depends on anything beyond
+// the exported State/Action/Heuristic interfaces.
+func (s *SearchSolver) Run() ([]Action, Statistics) {
+
+	switch s.Strategy {
+	case StrategyIterativeDepth:
+		return s.runIterativeDeepening()
+	case StrategyAstar, StrategyGreedyBestFirst:
+		return s.runBestFirst()
+	default:
+		return s.runUninformed()
+	}
+}
+
+// costOf resolves the cost of applying action a to go from "from" to
+// "to", honouring a custom Cost function when one is configured.
+func (s *SearchSolver) costOf(from State, a Action, to State) float64 {
+	if s.Cost != nil {
+		return s.Cost(from, a, to)
+	}
+	return a.Cost()
+}
+
+// heuristicOf resolves the heuristic value of a state, honouring a
+// custom Heuristic function when one is configured and falling back
+// to the Heuristic interface otherwise. It returns 0 (uninformed
+// search) when neither is available.
+func (s *SearchSolver) heuristicOf(state State) float64 {
+	if s.Heuristic != nil {
+		return s.Heuristic(state)
+	}
+	if h, ok := state.(Heuristic); ok {
+		return h.Heuristic()
+	}
+	return 0
+}
+
+// runUninformed drives StrategyBreadthFirst and StrategyDepthFirst
+// (StrategyIterativeDepth has its own runIterativeDeepening loop)
+// with support for MaxDepth, MaxNodes and Dedup.
+func (s *SearchSolver) runUninformed() ([]Action, Statistics) {
+
+	var frontier []State
+	frontier = append(frontier, s.Initial)
+	visited := map[string]bool{s.Initial.String(): true}
+	stats := Statistics{}
+
+	pop := func() State {
+		var next State
+		if s.Strategy == StrategyDepthFirst {
+			last := len(frontier) - 1
+			next, frontier = frontier[last], frontier[:last]
+		} else {
+			next, frontier = frontier[0], frontier[1:]
+		}
+		return next
+	}
+
+	for len(frontier) > 0 {
+		if s.MaxNodes > 0 && stats.NodesExplored >= s.MaxNodes {
+			break
+		}
+
+		current := pop()
+		stats.NodesExplored++
+		stats.MaxDepth = max(stats.MaxDepth, current.GetStateLevel())
+
+		if current.IsSolution() {
+			stats.Solutions++
+			return current.GetPartialSolution(), stats
+		}
+
+		if s.MaxDepth > 0 && current.GetStateLevel() >= s.MaxDepth {
+			continue
+		}
+
+		for _, action := range current.GetApplicableActions() {
+			next := current.ApplyAction(action)
+			if s.Dedup {
+				key := next.String()
+				if visited[key] {
+					stats.NodesDuplicated++
+					continue
+				}
+				visited[key] = true
+			}
+			frontier = append(frontier, next)
+		}
+	}
+
+	return []Action{}, stats
+}
+
+// runIterativeDeepening drives StrategyIterativeDepth: it repeats a
+// depth-bounded depth-first search (boundedDepthFirst) with a bound
+// that starts at 1 and grows by 1 each time the previous bound failed
+// to reach the full depth of the tree, exactly like
+// SearchIterativeDepth. MaxNodes is honoured across the whole run
+// (not just one bound), and MaxDepth, if set, is the final bound
+// tried before giving up instead of growing forever.
+func (s *SearchSolver) runIterativeDeepening() ([]Action, Statistics) {
+
+	stats := Statistics{}
+	bound := 1
+
+	for {
+		solution, boundReached, iteration := s.boundedDepthFirst(bound, s.MaxNodes-stats.NodesExplored)
+
+		stats.NodesExplored += iteration.NodesExplored
+		stats.NodesDuplicated += iteration.NodesDuplicated
+		stats.MaxDepth = max(stats.MaxDepth, iteration.MaxDepth)
+
+		if len(solution) > 0 {
+			stats.Solutions++
+			return solution, stats
+		}
+
+		if bound > boundReached {
+			return []Action{}, stats // the whole tree was exhausted before the bound
+		}
+		if s.MaxNodes > 0 && stats.NodesExplored >= s.MaxNodes {
+			return []Action{}, stats
+		}
+		if s.MaxDepth > 0 && bound >= s.MaxDepth {
+			return []Action{}, stats
+		}
+
+		bound++
+	}
+}
+
+// boundedDepthFirst runs one depth-limited DFS iteration of
+// runIterativeDeepening: it explores no deeper than bound and stops
+// early once nodesLeft nodes have been explored (nodesLeft <= 0 means
+// unbounded, matching MaxNodes == 0). It returns the solution actions
+// when found (nil otherwise), the deepest level actually reached
+// (used to detect that the whole state space was exhausted before
+// bound), and the Statistics for this iteration alone.
+func (s *SearchSolver) boundedDepthFirst(bound, nodesLeft int) ([]Action, int, Statistics) {
+
+	stats := Statistics{}
+	deepest := 0
+	visited := map[string]bool{s.Initial.String(): true}
+	var solution []Action
+
+	var recurse func(state State)
+	recurse = func(state State) {
+		if solution != nil || (nodesLeft > 0 && stats.NodesExplored >= nodesLeft) {
+			return
+		}
+
+		stats.NodesExplored++
+		deepest = max(deepest, state.GetStateLevel())
+
+		if state.IsSolution() {
+			solution = state.GetPartialSolution()
+			return
+		}
+
+		if state.GetStateLevel() >= bound {
+			return
+		}
+
+		for _, action := range state.GetApplicableActions() {
+			next := state.ApplyAction(action)
+			if s.Dedup {
+				key := next.String()
+				if visited[key] {
+					stats.NodesDuplicated++
+					continue
+				}
+				visited[key] = true
+			}
+			recurse(next)
+			if solution != nil {
+				return
+			}
+		}
+	}
+
+	recurse(s.Initial)
+	return solution, deepest, stats
+}
+
+// runBestFirst drives StrategyAstar and StrategyGreedyBestFirst with
+// support for MaxDepth, MaxNodes, Dedup, Weight and custom cost/
+// heuristic functions.
+func (s *SearchSolver) runBestFirst() ([]Action, Statistics) {
+
+	weight := s.Weight
+	if weight == 0 {
+		weight = 1
+	}
+
+	type entry struct {
+		state State
+		g     float64
+	}
+
+	frontier := []entry{{s.Initial, 0}}
+	visited := map[string]bool{s.Initial.String(): true}
+	stats := Statistics{}
+
+	for len(frontier) > 0 {
+		if s.MaxNodes > 0 && stats.NodesExplored >= s.MaxNodes {
+			break
+		}
+
+		// Pick the entry with the lowest priority; a linear scan keeps
+		// this generic path self-contained rather than depending on a
+		// dedicated priority queue implementation.
+		best := 0
+		bestPriority := math.Inf(1)
+		for i, e := range frontier {
+			priority := e.g
+			if s.Strategy == StrategyGreedyBestFirst {
+				priority = 0
+			}
+			priority += weight * s.heuristicOf(e.state)
+			if priority < bestPriority {
+				bestPriority = priority
+				best = i
+			}
+		}
+
+		current := frontier[best]
+		frontier = append(frontier[:best], frontier[best+1:]...)
+
+		stats.NodesExplored++
+		stats.MaxDepth = max(stats.MaxDepth, current.state.GetStateLevel())
+
+		if current.state.IsSolution() {
+			stats.Solutions++
+			return current.state.GetPartialSolution(), stats
+		}
+
+		if s.MaxDepth > 0 && current.state.GetStateLevel() >= s.MaxDepth {
+			continue
+		}
+
+		for _, action := range current.state.GetApplicableActions() {
+			next := current.state.ApplyAction(action)
+			if s.Dedup {
+				key := next.String()
+				if visited[key] {
+					stats.NodesDuplicated++
+					continue
+				}
+				visited[key] = true
+			}
+			frontier = append(frontier, entry{next, current.g + s.costOf(current.state, action, next)})
+		}
+	}
+
+	return []Action{}, stats
+}
@@ -0,0 +1,165 @@
+package gosearch
+
+import "testing"
+
+// solverBranchAction is one of the two choices available at every
+// node of solverBranchState's infinite binary tree.
+type solverBranchAction struct {
+	id int
+}
+
+func (a solverBranchAction) Cost() float64 { return 1 }
+
+// solverBranchState is an infinite binary-branch tree (every node has
+// exactly two children, forever) used to exercise MaxNodes/MaxDepth:
+// with neither set, a search here would never terminate. When target
+// is non-zero, the single path of all id==1 actions of that length is
+// a solution, letting MaxDepth be tested against a real depth cutoff
+// instead of just truncating an endless search.
+type solverBranchState struct {
+	path   []Action
+	target int
+}
+
+func (s solverBranchState) ApplyAction(action Action) State {
+	return solverBranchState{
+		path:   append(append([]Action{}, s.path...), action),
+		target: s.target,
+	}
+}
+
+func (s solverBranchState) GetPartialSolution() []Action { return s.path }
+func (s solverBranchState) GetSolutionCost() float64     { return float64(len(s.path)) }
+
+func (s solverBranchState) GetApplicableActions() []Action {
+	return []Action{solverBranchAction{id: 0}, solverBranchAction{id: 1}}
+}
+
+func (s solverBranchState) IsSolution() bool {
+	if s.target == 0 || len(s.path) != s.target {
+		return false
+	}
+	for _, a := range s.path {
+		if a.(solverBranchAction).id != 1 {
+			return false
+		}
+	}
+	return true
+}
+
+func (s solverBranchState) Equal(other State) bool {
+	o, ok := other.(solverBranchState)
+	return ok && s.String() == o.String()
+}
+
+func (s solverBranchState) GetStateLevel() int { return len(s.path) }
+
+func (s solverBranchState) String() string {
+	b := make([]byte, len(s.path))
+	for i, a := range s.path {
+		b[i] = byte('0' + a.(solverBranchAction).id)
+	}
+	return string(b)
+}
+
+func TestSearchSolverMaxNodesCutoff(t *testing.T) {
+	solver := NewSearchSolver(solverBranchState{})
+	solver.MaxNodes = 50
+
+	actions, stats := solver.Run()
+
+	if len(actions) != 0 {
+		t.Fatalf("expected no solution, got %v", actions)
+	}
+	if stats.NodesExplored != 50 {
+		t.Fatalf("expected MaxNodes to stop the search at exactly 50 nodes, got %d", stats.NodesExplored)
+	}
+}
+
+func TestSearchSolverMaxDepthCutoff(t *testing.T) {
+	initial := solverBranchState{target: 5}
+
+	tooShallow := NewSearchSolver(initial)
+	tooShallow.MaxDepth = 3
+	if actions, _ := tooShallow.Run(); len(actions) != 0 {
+		t.Fatalf("expected MaxDepth=3 to hide the depth-5 solution, got %v", actions)
+	}
+
+	deepEnough := NewSearchSolver(initial)
+	deepEnough.MaxDepth = 5
+	actions, stats := deepEnough.Run()
+	if len(actions) != 5 {
+		t.Fatalf("expected the depth-5 solution, got %d actions", len(actions))
+	}
+	for _, a := range actions {
+		if a.(solverBranchAction).id != 1 {
+			t.Fatalf("expected every action to be id 1, got %v", actions)
+		}
+	}
+	if stats.Solutions != 1 {
+		t.Fatalf("expected exactly one solution counted, got %d", stats.Solutions)
+	}
+}
+
+func TestSearchSolverDedupOnOff(t *testing.T) {
+	initial := cycleState{value: 0, modulus: 6}
+
+	dedupOn := NewSearchSolver(initial)
+	_, stats := dedupOn.Run()
+	if stats.NodesExplored != 6 {
+		t.Fatalf("expected Dedup (the default) to stop after the 6 distinct states in the cycle, got %d", stats.NodesExplored)
+	}
+
+	dedupOff := NewSearchSolver(initial)
+	dedupOff.Dedup = false
+	dedupOff.MaxNodes = 50
+	_, stats = dedupOff.Run()
+	if stats.NodesExplored != 50 {
+		t.Fatalf("expected Dedup=false to keep revisiting the cycle until MaxNodes, got %d", stats.NodesExplored)
+	}
+	if stats.NodesDuplicated != 0 {
+		t.Fatalf("expected no duplicates to be recorded once Dedup is off, got %d", stats.NodesDuplicated)
+	}
+}
+
+func TestSearchSolverWeightedAstarAndGreedyBestFirst(t *testing.T) {
+	initial := lineState{position: 0, target: 7}
+
+	astar := NewSearchSolver(initial)
+	astar.Strategy = StrategyAstar
+	actions, stats := astar.Run()
+	if len(actions) != 4 {
+		t.Fatalf("plain A* should find the optimal 4-action solution, got %d", len(actions))
+	}
+	if stats.Solutions != 1 {
+		t.Fatalf("expected exactly one solution counted, got %d", stats.Solutions)
+	}
+
+	weighted := NewSearchSolver(initial)
+	weighted.Strategy = StrategyAstar
+	weighted.Weight = 10
+	if actions := distanceCovered(t, weighted); actions != 7 {
+		t.Fatalf("weighted A* should still reach the target, covered %d", actions)
+	}
+
+	greedy := NewSearchSolver(initial)
+	greedy.Strategy = StrategyGreedyBestFirst
+	if actions := distanceCovered(t, greedy); actions != 7 {
+		t.Fatalf("greedy best-first should still reach the target, covered %d", actions)
+	}
+}
+
+// distanceCovered runs solver and returns the total distance its
+// solution covers, failing the test if no solution was found.
+func distanceCovered(t *testing.T, solver *SearchSolver) int {
+	t.Helper()
+	actions, _ := solver.Run()
+	if len(actions) == 0 {
+		t.Fatalf("expected a solution")
+	}
+	var covered int
+	for _, a := range actions {
+		covered += a.(lineAction).step
+	}
+	return covered
+}
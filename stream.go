@@ -0,0 +1,201 @@
+package gosearch
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// checkInterval is how many node expansions pass between checks of
+// ctx.Done() and the time budget inside the streaming searches below,
+// so that an expensive Heuristic or GetApplicableActions does not
+// delay reacting to a cancellation or an exhausted budget.
+const checkInterval = 100
+
+// Solution is one goal state found by a streaming search, reported as
+// the list of actions that reach it together with its cost.
+type Solution struct {
+	Actions []Action
+	Cost    float64
+}
+
+// SearchOptions bounds a streaming search.
+type SearchOptions struct {
+	// MaxNodes caps the number of nodes explored. Zero means unbounded.
+	MaxNodes int
+
+	// Budget caps the wall-clock time spent searching. Zero means unbounded.
+	Budget time.Duration
+
+	// MaxSolutions caps how many goal states are reported before the
+	// search stops. Zero means unbounded (explore the whole space).
+	MaxSolutions int
+}
+
+// streamOrder selects how streamFrontier.Pop picks the next state.
+type streamOrder int
+
+const (
+	streamFIFO streamOrder = iota
+	streamLIFO
+	streamPriority
+)
+
+// streamFrontier is a small self-contained open list used only by the
+// streaming searches below: a plain slice of states popped in FIFO,
+// LIFO or priority (g+h, falling back to g alone when the state has
+// no Heuristic) order.
+type streamFrontier struct {
+	states []State
+	order  streamOrder
+}
+
+func (f *streamFrontier) Push(s State) {
+	f.states = append(f.states, s)
+}
+
+func (f *streamFrontier) Len() int {
+	return len(f.states)
+}
+
+func (f *streamFrontier) Pop() State {
+	var index int
+	switch f.order {
+	case streamLIFO:
+		index = len(f.states) - 1
+	case streamPriority:
+		index = f.cheapestIndex()
+	default: // streamFIFO
+		index = 0
+	}
+
+	s := f.states[index]
+	f.states = append(f.states[:index], f.states[index+1:]...)
+	return s
+}
+
+func (f *streamFrontier) cheapestIndex() int {
+	best := 0
+	bestPriority := math.Inf(1)
+	for i, s := range f.states {
+		priority := s.GetSolutionCost()
+		if h, ok := s.(Heuristic); ok {
+			priority += h.Heuristic()
+		}
+		if priority < bestPriority {
+			bestPriority = priority
+			best = i
+		}
+	}
+	return best
+}
+
+// SearchAllAstar streams every goal state reachable from initial,
+// ordered by the A* frontier, instead of stopping at the first one
+// found. The State must implement the Heuristic interface.
+// Previously-seen states (compared by String()) are skipped so
+// revisitable state spaces don't get
+// re-explored or re-reported. The search stops, closing both
+// channels, when ctx is cancelled, opts.Budget elapses, opts.MaxNodes
+// nodes have been explored, opts.MaxSolutions solutions have been
+// reported, or the frontier is exhausted; the final Statistics are
+// always sent on the statistics channel before it closes. This is
+// meant for long-running interactive planners that want to show
+// progressive solutions rather than block for the single best one.
+func SearchAllAstar(ctx context.Context, initial State, opts SearchOptions) (<-chan Solution, <-chan Statistics) {
+	return streamSearch(ctx, initial, opts, &streamFrontier{order: streamPriority})
+}
+
+// SearchAllBreadthFirst streams every goal state reachable from
+// initial in breadth-first order, honouring ctx, opts and
+// duplicate-detection exactly like SearchAllAstar.
+func SearchAllBreadthFirst(ctx context.Context, initial State, opts SearchOptions) (<-chan Solution, <-chan Statistics) {
+	return streamSearch(ctx, initial, opts, &streamFrontier{order: streamFIFO})
+}
+
+// SearchAllDepthFirst streams every goal state reachable from initial
+// in depth-first order, honouring ctx, opts and duplicate-detection
+// exactly like SearchAllAstar.
+func SearchAllDepthFirst(ctx context.Context, initial State, opts SearchOptions) (<-chan Solution, <-chan Statistics) {
+	return streamSearch(ctx, initial, opts, &streamFrontier{order: streamLIFO})
+}
+
+// streamSearch backs SearchAllAstar/SearchAllBreadthFirst/SearchAllDepthFirst.
+func streamSearch(ctx context.Context, initial State, opts SearchOptions, pending *streamFrontier) (<-chan Solution, <-chan Statistics) {
+
+	solutions := make(chan Solution)
+	statistics := make(chan Statistics, 1)
+
+	go func() {
+		defer close(solutions)
+		defer close(statistics)
+
+		deadline := streamDeadline(opts.Budget)
+		pending.Push(initial)
+		visited := map[string]bool{initial.String(): true}
+		stats := Statistics{}
+
+		for pending.Len() > 0 {
+			if stats.NodesExplored%checkInterval == 0 && streamShouldStop(ctx, deadline) {
+				statistics <- stats
+				return
+			}
+			if opts.MaxNodes > 0 && stats.NodesExplored >= opts.MaxNodes {
+				break
+			}
+
+			current := pending.Pop()
+			stats.NodesExplored++
+			stats.MaxDepth = max(stats.MaxDepth, current.GetStateLevel())
+
+			if current.IsSolution() {
+				stats.Solutions++
+				select {
+				case solutions <- Solution{Actions: current.GetPartialSolution(), Cost: current.GetSolutionCost()}:
+				case <-ctx.Done():
+					statistics <- stats
+					return
+				}
+				if opts.MaxSolutions > 0 && stats.Solutions >= opts.MaxSolutions {
+					break
+				}
+				continue
+			}
+
+			for _, action := range current.GetApplicableActions() {
+				next := current.ApplyAction(action)
+				key := next.String()
+				if visited[key] {
+					stats.NodesDuplicated++
+					continue
+				}
+				visited[key] = true
+				pending.Push(next)
+			}
+		}
+
+		statistics <- stats
+	}()
+
+	return solutions, statistics
+}
+
+// streamDeadline turns a zero-or-positive Budget into an absolute
+// time.Time, or the zero time.Time when budget is zero (no deadline).
+func streamDeadline(budget time.Duration) time.Time {
+	if budget <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(budget)
+}
+
+// streamShouldStop reports whether ctx has been cancelled or deadline
+// has passed.
+func streamShouldStop(ctx context.Context, deadline time.Time) bool {
+	select {
+	case <-ctx.Done():
+		return true
+	default:
+	}
+	return !deadline.IsZero() && time.Now().After(deadline)
+}
@@ -0,0 +1,73 @@
+package gosearch
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// cycleAction advances a cycleState by one step around its cycle.
+type cycleAction struct{}
+
+func (cycleAction) Cost() float64 { return 1 }
+
+// cycleState is a State that never reaches a solution and whose
+// successor wraps back to an already-seen value after modulus steps,
+// used to exercise duplicate-detection in the streaming searches:
+// without it, exploring this state space never terminates.
+type cycleState struct {
+	value   int
+	modulus int
+	level   int
+}
+
+func (s cycleState) ApplyAction(Action) State {
+	return cycleState{value: (s.value + 1) % s.modulus, modulus: s.modulus, level: s.level + 1}
+}
+
+func (s cycleState) GetPartialSolution() []Action { return nil }
+func (s cycleState) GetSolutionCost() float64     { return float64(s.level) }
+func (s cycleState) GetApplicableActions() []Action {
+	return []Action{cycleAction{}}
+}
+func (s cycleState) IsSolution() bool { return false }
+
+func (s cycleState) Equal(other State) bool {
+	o, ok := other.(cycleState)
+	return ok && o.value == s.value
+}
+
+func (s cycleState) GetStateLevel() int { return s.level }
+func (s cycleState) String() string     { return strconv.Itoa(s.value) }
+
+func TestSearchAllBreadthFirstDedupsCycle(t *testing.T) {
+	initial := cycleState{value: 0, modulus: 5}
+	solutions, statistics := SearchAllBreadthFirst(context.Background(), initial, SearchOptions{MaxNodes: 1000})
+
+	for range solutions {
+		t.Fatalf("cycleState never reaches a solution")
+	}
+
+	stats := <-statistics
+	if stats.NodesExplored != 5 {
+		t.Fatalf("expected duplicate detection to stop after the 5 distinct states in the cycle, got %d", stats.NodesExplored)
+	}
+}
+
+func TestSearchAllBreadthFirstRespectsCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	initial := cycleState{value: 0, modulus: 1000000}
+	solutions, statistics := SearchAllBreadthFirst(ctx, initial, SearchOptions{})
+
+	for range solutions {
+	}
+
+	select {
+	case <-statistics:
+	case <-time.After(time.Second):
+		t.Fatalf("expected the search to stop promptly once ctx was already cancelled")
+	}
+}